@@ -0,0 +1,139 @@
+/*
+Package prque provides a priority queue built on top of a binary heap.
+Like the ring-buffer queue in the parent package, it grows and shrinks by
+powers of two so that amortized push/pop cost stays low.
+
+The priority queue implemented here is as fast as it is for an additional
+reason: it is *not* thread-safe.
+*/
+package prque
+
+import (
+	"errors"
+)
+
+// minQueueLen is smallest capacity that queue may have.
+// It is a power of 2, so every capacity reached by doubling and halving is too.
+const minQueueLen = 16
+
+var ErrQueueEmpty = errors.New("queue is empty")
+
+// entry pairs a stored value with the priority it was pushed with.
+type entry struct {
+	value    interface{}
+	priority float64
+}
+
+// PriorityQueue represents a single instance of the priority queue data
+// structure. Lower priority values are popped first.
+type PriorityQueue struct {
+	buf   []entry
+	count int
+}
+
+// New constructs and returns a new PriorityQueue.
+func New() *PriorityQueue {
+	return &PriorityQueue{
+		buf: make([]entry, minQueueLen),
+	}
+}
+
+// Length returns the number of elements currently stored in the queue.
+func (q *PriorityQueue) Length() int {
+	return q.count
+}
+
+// resizes the queue to fit exactly twice its current contents
+// this can result in shrinking if the queue is less than half-full
+func (q *PriorityQueue) resize() {
+	newBuf := make([]entry, q.count<<1)
+	copy(newBuf, q.buf[:q.count])
+	q.buf = newBuf
+}
+
+// Push adds v to the queue with the given priority.
+func (q *PriorityQueue) Push(v interface{}, prio float64) {
+	if q.count == len(q.buf) {
+		q.resize()
+	}
+
+	q.buf[q.count] = entry{value: v, priority: prio}
+	q.count++
+	q.up(q.count - 1)
+}
+
+// Peek returns the value with the lowest priority in the queue, without
+// removing it. This call returns error if the queue is empty.
+func (q *PriorityQueue) Peek() (interface{}, float64, error) {
+	if q.count <= 0 {
+		return nil, 0, ErrQueueEmpty
+	}
+	return q.buf[0].value, q.buf[0].priority, nil
+}
+
+// Pop removes and returns the value with the lowest priority in the queue.
+// This call returns error if the queue is empty.
+func (q *PriorityQueue) Pop() (interface{}, float64, error) {
+	if q.count <= 0 {
+		return nil, 0, ErrQueueEmpty
+	}
+	return q.Remove(0)
+}
+
+// Remove removes and returns the value at heap index idx. Index 0 always
+// refers to the element with the lowest priority; other indices are only
+// meaningful in relation to the heap's internal ordering. This call returns
+// error if idx is out of range.
+func (q *PriorityQueue) Remove(idx int) (interface{}, float64, error) {
+	if idx < 0 || idx >= q.count {
+		return nil, 0, ErrQueueEmpty
+	}
+
+	ret := q.buf[idx]
+	q.count--
+	if idx != q.count {
+		q.buf[idx] = q.buf[q.count]
+		q.down(idx)
+		q.up(idx)
+	}
+	q.buf[q.count] = entry{}
+
+	// Resize down if buffer 1/4 full.
+	if len(q.buf) > minQueueLen && (q.count<<2) == len(q.buf) {
+		q.resize()
+	}
+	return ret.value, ret.priority, nil
+}
+
+// up restores the heap property by moving the entry at index i towards the
+// root for as long as it is lower priority than its parent.
+func (q *PriorityQueue) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if q.buf[i].priority >= q.buf[parent].priority {
+			break
+		}
+		q.buf[i], q.buf[parent] = q.buf[parent], q.buf[i]
+		i = parent
+	}
+}
+
+// down restores the heap property by moving the entry at index i towards
+// the leaves for as long as one of its children has a lower priority.
+func (q *PriorityQueue) down(i int) {
+	for {
+		left := 2*i + 1
+		if left >= q.count {
+			break
+		}
+		smallest := left
+		if right := left + 1; right < q.count && q.buf[right].priority < q.buf[left].priority {
+			smallest = right
+		}
+		if q.buf[i].priority <= q.buf[smallest].priority {
+			break
+		}
+		q.buf[i], q.buf[smallest] = q.buf[smallest], q.buf[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,87 @@
+package prque
+
+import "testing"
+
+func TestPriorityQueueOrdering(t *testing.T) {
+	q := New()
+
+	q.Push("c", 3)
+	q.Push("a", 1)
+	q.Push("b", 2)
+
+	for _, want := range []string{"a", "b", "c"} {
+		v, _, err := q.Pop()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.(string) != want {
+			t.Error("pop had value", v, "wanted", want)
+		}
+	}
+
+	if _, _, err := q.Pop(); err != ErrQueueEmpty {
+		t.Error("should error when popping empty queue")
+	}
+}
+
+func TestPriorityQueueLength(t *testing.T) {
+	q := New()
+
+	if q.Length() != 0 {
+		t.Error("empty queue length not 0")
+	}
+
+	for i := 0; i < 1000; i++ {
+		q.Push(i, float64(1000-i))
+		if q.Length() != i+1 {
+			t.Error("adding: queue with", i, "elements has length", q.Length())
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		q.Pop()
+		if q.Length() != 1000-i-1 {
+			t.Error("removing: queue with", 1000-i-1, "elements has length", q.Length())
+		}
+	}
+}
+
+func TestPriorityQueuePeek(t *testing.T) {
+	q := New()
+
+	if _, _, err := q.Peek(); err != ErrQueueEmpty {
+		t.Error("should error when peeking empty queue")
+	}
+
+	q.Push("only", 5)
+	v, prio, err := q.Peek()
+	if err != nil || v.(string) != "only" || prio != 5 {
+		t.Error("peek returned", v, prio, err)
+	}
+	if q.Length() != 1 {
+		t.Error("peek should not remove the element")
+	}
+}
+
+func TestPriorityQueueRemove(t *testing.T) {
+	q := New()
+
+	for i := 0; i < 10; i++ {
+		q.Push(i, float64(i))
+	}
+
+	if _, _, err := q.Remove(100); err != ErrQueueEmpty {
+		t.Error("should error when removing out-of-range index")
+	}
+
+	v, prio, err := q.Remove(0)
+	if err != nil || v.(int) != 0 || prio != 0 {
+		t.Error("remove returned", v, prio, err)
+	}
+
+	for i := 1; i < 10; i++ {
+		v, _, err := q.Pop()
+		if err != nil || v.(int) != i {
+			t.Error("pop had value", v, "wanted", i)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Package prque provides a priority queue built on top of a binary heap.
+Like the generic ring-buffer queue in package queue, it grows and shrinks by
+powers of two so that amortized push/pop cost stays low.
+
+The priority queue implemented here is as fast as it is for an additional
+reason: it is *not* thread-safe.
+*/
+package prque
+
+import (
+	"errors"
+)
+
+// minQueueLen is smallest capacity that queue may have.
+// It is a power of 2, so every capacity reached by doubling and halving is too.
+const minQueueLen = 16
+
+var ErrQueueEmpty = errors.New("queue is empty")
+
+// Ordered is the set of types whose values can be compared with the < and >
+// operators, mirroring golang.org/x/exp/constraints.Ordered without taking
+// on the extra dependency.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// entry pairs a stored value with the priority it was pushed with.
+type entry[V any, P Ordered] struct {
+	value    V
+	priority P
+}
+
+// PriorityQueue represents a single instance of the priority queue data
+// structure. Lower priority values are popped first.
+type PriorityQueue[V any, P Ordered] struct {
+	buf   []entry[V, P]
+	count int
+}
+
+// New constructs and returns a new PriorityQueue.
+func New[V any, P Ordered]() *PriorityQueue[V, P] {
+	return &PriorityQueue[V, P]{
+		buf: make([]entry[V, P], minQueueLen),
+	}
+}
+
+// Length returns the number of elements currently stored in the queue.
+func (q *PriorityQueue[V, P]) Length() int {
+	return q.count
+}
+
+// resizes the queue to fit exactly twice its current contents
+// this can result in shrinking if the queue is less than half-full
+func (q *PriorityQueue[V, P]) resize() {
+	newBuf := make([]entry[V, P], q.count<<1)
+	copy(newBuf, q.buf[:q.count])
+	q.buf = newBuf
+}
+
+// Push adds v to the queue with the given priority.
+func (q *PriorityQueue[V, P]) Push(v V, prio P) {
+	if q.count == len(q.buf) {
+		q.resize()
+	}
+
+	q.buf[q.count] = entry[V, P]{value: v, priority: prio}
+	q.count++
+	q.up(q.count - 1)
+}
+
+// Peek returns the value with the lowest priority in the queue, without
+// removing it. This call returns error if the queue is empty.
+func (q *PriorityQueue[V, P]) Peek() (V, P, error) {
+	if q.count <= 0 {
+		return *new(V), *new(P), ErrQueueEmpty
+	}
+	return q.buf[0].value, q.buf[0].priority, nil
+}
+
+// Pop removes and returns the value with the lowest priority in the queue.
+// This call returns error if the queue is empty.
+func (q *PriorityQueue[V, P]) Pop() (V, P, error) {
+	if q.count <= 0 {
+		return *new(V), *new(P), ErrQueueEmpty
+	}
+	return q.Remove(0)
+}
+
+// Remove removes and returns the value at heap index idx. Index 0 always
+// refers to the element with the lowest priority; other indices are only
+// meaningful in relation to the heap's internal ordering. This call returns
+// error if idx is out of range.
+func (q *PriorityQueue[V, P]) Remove(idx int) (V, P, error) {
+	if idx < 0 || idx >= q.count {
+		return *new(V), *new(P), ErrQueueEmpty
+	}
+
+	ret := q.buf[idx]
+	q.count--
+	if idx != q.count {
+		q.buf[idx] = q.buf[q.count]
+		q.down(idx)
+		q.up(idx)
+	}
+	q.buf[q.count] = entry[V, P]{}
+
+	// Resize down if buffer 1/4 full.
+	if len(q.buf) > minQueueLen && (q.count<<2) == len(q.buf) {
+		q.resize()
+	}
+	return ret.value, ret.priority, nil
+}
+
+// up restores the heap property by moving the entry at index i towards the
+// root for as long as it is lower priority than its parent.
+func (q *PriorityQueue[V, P]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if q.buf[i].priority >= q.buf[parent].priority {
+			break
+		}
+		q.buf[i], q.buf[parent] = q.buf[parent], q.buf[i]
+		i = parent
+	}
+}
+
+// down restores the heap property by moving the entry at index i towards
+// the leaves for as long as one of its children has a lower priority.
+func (q *PriorityQueue[V, P]) down(i int) {
+	for {
+		left := 2*i + 1
+		if left >= q.count {
+			break
+		}
+		smallest := left
+		if right := left + 1; right < q.count && q.buf[right].priority < q.buf[left].priority {
+			smallest = right
+		}
+		if q.buf[i].priority <= q.buf[smallest].priority {
+			break
+		}
+		q.buf[i], q.buf[smallest] = q.buf[smallest], q.buf[i]
+		i = smallest
+	}
+}
@@ -43,7 +43,13 @@ func (q *Queue) Length() int {
 // resizes the queue to fit exactly twice its current contents
 // this can result in shrinking if the queue is less than half-full
 func (q *Queue) resize() {
-	newBuf := make([]interface{}, q.count<<1)
+	q.resizeTo(q.count << 1)
+}
+
+// resizeTo rebuilds the queue around a buffer of the given capacity,
+// preserving order and unwrapping the contents so head sits at 0.
+func (q *Queue) resizeTo(newCap int) {
+	newBuf := make([]interface{}, newCap)
 
 	if q.tail > q.head {
 		copy(newBuf, q.buf[q.head:q.tail])
@@ -57,6 +63,16 @@ func (q *Queue) resize() {
 	q.buf = newBuf
 }
 
+// nextPowerOfTwo returns the smallest power of two that is >= n and >=
+// minQueueLen.
+func nextPowerOfTwo(n int) int {
+	newLen := minQueueLen
+	for newLen < n {
+		newLen <<= 1
+	}
+	return newLen
+}
+
 // Add puts an element on the end of the queue.
 func (q *Queue) Add(elem interface{}) {
 	q.mu.Lock()
@@ -118,3 +134,118 @@ func (q *Queue) Remove() (interface{}, error) {
 	}
 	return ret, nil
 }
+
+// AddAll puts every element of elems on the end of the queue, growing the
+// underlying buffer at most once regardless of how many elements are
+// added.
+func (q *Queue) AddAll(elems []interface{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(elems) == 0 {
+		return
+	}
+
+	need := q.count + len(elems)
+	if need > len(q.buf) {
+		q.resizeTo(nextPowerOfTwo(need))
+	}
+
+	end := q.tail + len(elems)
+	if end <= len(q.buf) {
+		copy(q.buf[q.tail:end], elems)
+	} else {
+		n := copy(q.buf[q.tail:], elems)
+		copy(q.buf, elems[n:])
+	}
+	// bitwise modulus
+	q.tail = (q.tail + len(elems)) & (len(q.buf) - 1)
+	q.count += len(elems)
+}
+
+// RemoveN removes and returns up to n elements from the front of the queue,
+// in FIFO order. If the queue is empty, the call will return error;
+// otherwise it returns as many elements as are available, which may be
+// fewer than n.
+func (q *Queue) RemoveN(n int) ([]interface{}, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count <= 0 {
+		return nil, ErrQueueEmpty
+	}
+	if n > q.count {
+		n = q.count
+	}
+
+	ret := make([]interface{}, n)
+	end := q.head + n
+	if end <= len(q.buf) {
+		copy(ret, q.buf[q.head:end])
+		for i := q.head; i < end; i++ {
+			q.buf[i] = nil
+		}
+	} else {
+		m := copy(ret, q.buf[q.head:])
+		copy(ret[m:], q.buf[:n-m])
+		for i := q.head; i < len(q.buf); i++ {
+			q.buf[i] = nil
+		}
+		for i := 0; i < n-m; i++ {
+			q.buf[i] = nil
+		}
+	}
+	// bitwise modulus
+	q.head = (q.head + n) & (len(q.buf) - 1)
+	q.count -= n
+
+	// Resize down if buffer is a quarter full, matching Remove's threshold.
+	if len(q.buf) > minQueueLen && (q.count<<2) == len(q.buf) {
+		q.resize()
+	}
+	return ret, nil
+}
+
+// Drain removes and returns every element currently in the queue, in FIFO
+// order, resetting the buffer back down to its minimum size.
+func (q *Queue) Drain() []interface{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count <= 0 {
+		return nil
+	}
+
+	ret := make([]interface{}, q.count)
+	if q.tail > q.head {
+		copy(ret, q.buf[q.head:q.tail])
+	} else {
+		n := copy(ret, q.buf[q.head:])
+		copy(ret[n:], q.buf[:q.tail])
+	}
+
+	q.head, q.tail, q.count = 0, 0, 0
+	q.buf = make([]interface{}, minQueueLen)
+	return ret
+}
+
+// Snapshot returns a freshly allocated slice holding every element
+// currently in the queue, in FIFO order. It does not remove anything from
+// the queue.
+func (q *Queue) Snapshot() []interface{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	ret := make([]interface{}, q.count)
+	if q.count <= 0 {
+		return ret
+	}
+
+	if q.tail > q.head {
+		copy(ret, q.buf[q.head:q.tail])
+	} else {
+		n := copy(ret, q.buf[q.head:])
+		copy(ret[n:], q.buf[:q.tail])
+	}
+	return ret
+}
@@ -77,7 +77,7 @@ func TestQueuePops(t *testing.T) {
 	}
 
 	for i := 0; i < 1000; i++ {
-		if e, _ := q.Pop(); e != i {
+		if e, _ := q.Remove(); e != i {
 			t.Errorf("index %d doesn't contain %d", i, i)
 		}
 	}
@@ -119,18 +119,109 @@ func TestQueuePeekOutOfRangeErrors(t *testing.T) {
 func TestQueueRemoveOutOfRangeErrors(t *testing.T) {
 	q := New()
 
-	if q.Remove() == nil {
+	if _, err := q.Remove(); err == nil {
 		t.Error("should error when removing empty queue")
 	}
 
 	q.Add(1)
 	q.Remove()
 
-	if q.Remove() == nil {
+	if _, err := q.Remove(); err == nil {
 		t.Error("should error when removing emptied queue")
 	}
 }
 
+func TestQueueAddAll(t *testing.T) {
+	q := New()
+
+	q.Add(-1)
+	q.AddAll([]interface{}{0, 1, 2, 3})
+
+	for i := -1; i < 4; i++ {
+		if e, _ := q.Peek(); e.(int) != i {
+			t.Error("peek", i, "had value", e)
+		}
+		q.Remove()
+	}
+}
+
+func TestQueueRemoveN(t *testing.T) {
+	q := New()
+
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+
+	elems, err := q.RemoveN(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range elems {
+		if e.(int) != i {
+			t.Error("removen", i, "had value", e)
+		}
+	}
+	if q.Length() != 6 {
+		t.Error("queue should have 6 elements left, has", q.Length())
+	}
+
+	elems, err = q.RemoveN(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(elems) != 6 {
+		t.Error("removen should clamp to remaining length, got", len(elems))
+	}
+
+	if _, err := q.RemoveN(1); err != ErrQueueEmpty {
+		t.Error("should error when removing from empty queue")
+	}
+}
+
+func TestQueueDrain(t *testing.T) {
+	q := New()
+
+	if q.Drain() != nil {
+		t.Error("draining an empty queue should return nil")
+	}
+
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+
+	elems := q.Drain()
+	for i, e := range elems {
+		if e.(int) != i {
+			t.Error("drain", i, "had value", e)
+		}
+	}
+	if q.Length() != 0 {
+		t.Error("queue should be empty after drain, has", q.Length())
+	}
+}
+
+func TestQueueSnapshot(t *testing.T) {
+	q := New()
+
+	if s := q.Snapshot(); len(s) != 0 {
+		t.Errorf("snapshot of empty queue should be empty, got %v", s)
+	}
+
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+
+	snap := q.Snapshot()
+	for i, v := range snap {
+		if v.(int) != i {
+			t.Errorf("snapshot[%d] = %v, want %d", i, v, i)
+		}
+	}
+	if q.Length() != 10 {
+		t.Error("snapshot should not remove elements from the queue")
+	}
+}
+
 // General warning: Go's benchmark utility (go test -bench .) increases the number of
 // iterations until the benchmarks take a reasonable amount of time to run; memory usage
 // is *NOT* considered. On my machine, these benchmarks hit around ~1GB before they've had
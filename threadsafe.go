@@ -58,7 +58,7 @@ func (t *ThreadSafeQueue) Pop() (interface{}, error) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
-	return t.q.Pop()
+	return t.q.Remove()
 }
 
 // Remove removes the element from the front of the queue. If you actually
@@ -67,5 +67,46 @@ func (t *ThreadSafeQueue) Remove() error {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
-	return t.q.Remove()
+	_, err := t.q.Remove()
+	return err
+}
+
+// AddAll puts every element of elems on the end of the queue, taking the
+// lock once for the whole batch.
+func (t *ThreadSafeQueue) AddAll(elems []interface{}) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.q.AddAll(elems)
+}
+
+// RemoveN removes and returns up to n elements from the front of the queue,
+// taking the lock once for the whole batch. If the queue is empty, the
+// call will return error.
+func (t *ThreadSafeQueue) RemoveN(n int) ([]interface{}, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.q.RemoveN(n)
+}
+
+// Drain removes and returns every element currently in the queue, taking
+// the lock once for the whole batch.
+func (t *ThreadSafeQueue) Drain() []interface{} {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.q.Drain()
+}
+
+// Snapshot returns a freshly allocated slice holding every element
+// currently in the queue, in FIFO order, taking the lock once so the copy
+// is consistent even while other goroutines are adding or removing
+// elements. Callers can then range over the result without holding the
+// queue lock.
+func (t *ThreadSafeQueue) Snapshot() []interface{} {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.q.Snapshot()
 }
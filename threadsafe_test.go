@@ -131,6 +131,77 @@ func TestTsQueueRemoveOutOfRangeErrors(t *testing.T) {
 	}
 }
 
+func TestTsQueueAddAll(t *testing.T) {
+	q := NewThreadSafe()
+
+	q.Add(-1)
+	q.AddAll([]interface{}{0, 1, 2, 3})
+
+	for i := -1; i < 4; i++ {
+		if e, _ := q.Peek(); e.(int) != i {
+			t.Error("peek", i, "had value", e)
+		}
+		q.Remove()
+	}
+}
+
+func TestTsQueueRemoveN(t *testing.T) {
+	q := NewThreadSafe()
+
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+
+	elems, err := q.RemoveN(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range elems {
+		if e.(int) != i {
+			t.Error("removen", i, "had value", e)
+		}
+	}
+	if q.Length() != 6 {
+		t.Error("queue should have 6 elements left, has", q.Length())
+	}
+}
+
+func TestTsQueueDrain(t *testing.T) {
+	q := NewThreadSafe()
+
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+
+	elems := q.Drain()
+	for i, e := range elems {
+		if e.(int) != i {
+			t.Error("drain", i, "had value", e)
+		}
+	}
+	if q.Length() != 0 {
+		t.Error("queue should be empty after drain, has", q.Length())
+	}
+}
+
+func TestTsQueueSnapshot(t *testing.T) {
+	q := NewThreadSafe()
+
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+
+	snap := q.Snapshot()
+	for i, v := range snap {
+		if v.(int) != i {
+			t.Errorf("snapshot[%d] = %v, want %d", i, v, i)
+		}
+	}
+	if q.Length() != 10 {
+		t.Error("snapshot should not remove elements from the queue")
+	}
+}
+
 // General warning: Go's benchmark utility (go test -bench .) increases the number of
 // iterations until the benchmarks take a reasonable amount of time to run; memory usage
 // is *NOT* considered. On my machine, these benchmarks hit around ~1GB before they've had
@@ -0,0 +1,247 @@
+package queue
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrDisposed is returned by any BlockingQueue operation performed after
+// Dispose has been called, including operations that were already parked
+// waiting for space or an element.
+var ErrDisposed = errors.New("queue has been disposed")
+
+// getWaiter is a parked Get call. Put delivers directly into ch, bypassing
+// the ring buffer, so the waiter never has to re-check it.
+type getWaiter[V any] struct {
+	ch chan V
+}
+
+// putWaiter is a parked Put call, waiting for room to free up in a
+// capacity-bounded queue. It carries no payload: once signalled, the caller
+// re-attempts the Put from the top.
+type putWaiter struct {
+	ch chan struct{}
+}
+
+// BlockingQueue is a concurrent FIFO queue with context-aware blocking
+// Get/Put and support for disposal. Unlike a plain channel, it can be
+// peeked, polled with a timeout, and shut down from the outside.
+//
+// Consumers that call Get while the queue is empty are parked on an
+// internal FIFO list of waiters; Put wakes exactly one of them, in order,
+// handing the element straight to it when possible to avoid buffering it
+// at all.
+type BlockingQueue[V any] struct {
+	mu       sync.Mutex
+	q        *Queue[V]
+	capacity int // 0 means unbounded
+
+	getters *list.List // of *getWaiter[V], oldest first
+	putters *list.List // of *putWaiter, oldest first
+
+	disposed   bool
+	disposedCh chan struct{}
+}
+
+// NewBlocking constructs and returns a new BlockingQueue. A capacity of 0
+// means the queue is unbounded and Put never blocks on space.
+func NewBlocking[V any](capacity int) *BlockingQueue[V] {
+	return &BlockingQueue[V]{
+		q:          New[V](),
+		capacity:   capacity,
+		getters:    list.New(),
+		putters:    list.New(),
+		disposedCh: make(chan struct{}),
+	}
+}
+
+// Disposed reports whether Dispose has been called.
+func (q *BlockingQueue[V]) Disposed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.disposed
+}
+
+// Length returns the number of elements currently buffered in the queue.
+// It does not count Gets that are parked waiting for an element.
+func (q *BlockingQueue[V]) Length() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.q.Length()
+}
+
+// Peek returns the element at the front of the queue without removing it.
+// It errors if the queue is empty, including when it is empty because a
+// Get is already parked waiting for an element. After Dispose it returns
+// ErrDisposed.
+func (q *BlockingQueue[V]) Peek() (V, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.disposed {
+		return *new(V), ErrDisposed
+	}
+	return q.q.Peek()
+}
+
+// Dispose shuts the queue down, waking every parked Get and Put with
+// ErrDisposed. Any operation performed after Dispose, including ones
+// already in flight, returns ErrDisposed.
+func (q *BlockingQueue[V]) Dispose() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.disposed {
+		return
+	}
+	q.disposed = true
+	close(q.disposedCh)
+}
+
+// Put adds v to the queue, blocking if the queue is at capacity until room
+// is available, ctx is done, or the queue is disposed. If a consumer is
+// already parked in Get, v is handed to it directly without touching the
+// ring buffer.
+func (q *BlockingQueue[V]) Put(ctx context.Context, v V) error {
+	for {
+		q.mu.Lock()
+		if q.disposed {
+			q.mu.Unlock()
+			return ErrDisposed
+		}
+
+		if front := q.getters.Front(); front != nil {
+			w := front.Value.(*getWaiter[V])
+			q.getters.Remove(front)
+			// w.ch is buffered (size 1), so this cannot block. It must
+			// happen before we unlock: otherwise ctx.Done()/Dispose could
+			// fire on the getter between the Remove above and the send,
+			// removeGetter would find it already off the list and report
+			// failure, and v would be stranded in a channel nobody reads.
+			w.ch <- v
+			q.mu.Unlock()
+			return nil
+		}
+
+		if q.capacity <= 0 || q.q.Length() < q.capacity {
+			q.q.Add(v)
+			q.mu.Unlock()
+			return nil
+		}
+
+		w := &putWaiter{ch: make(chan struct{}, 1)}
+		elem := q.putters.PushBack(w)
+		q.mu.Unlock()
+
+		select {
+		case <-w.ch:
+			// Room freed up (or we were picked to retry); loop around and
+			// attempt the put again.
+		case <-ctx.Done():
+			q.removePutter(elem)
+			return ctx.Err()
+		case <-q.disposedCh:
+			q.removePutter(elem)
+			return ErrDisposed
+		}
+	}
+}
+
+// Get removes and returns the element at the front of the queue, blocking
+// if the queue is empty until an element is available, ctx is done, or the
+// queue is disposed.
+func (q *BlockingQueue[V]) Get(ctx context.Context) (V, error) {
+	q.mu.Lock()
+	if q.disposed {
+		q.mu.Unlock()
+		return *new(V), ErrDisposed
+	}
+
+	if q.q.Length() > 0 {
+		v, _ := q.q.Remove()
+		q.wakePutter()
+		q.mu.Unlock()
+		return v, nil
+	}
+
+	w := &getWaiter[V]{ch: make(chan V, 1)}
+	elem := q.getters.PushBack(w)
+	q.mu.Unlock()
+
+	select {
+	case v := <-w.ch:
+		return v, nil
+	case <-ctx.Done():
+		if v, ok := q.removeGetter(elem, w); ok {
+			return v, nil
+		}
+		return *new(V), ctx.Err()
+	case <-q.disposedCh:
+		if v, ok := q.removeGetter(elem, w); ok {
+			return v, nil
+		}
+		return *new(V), ErrDisposed
+	}
+}
+
+// PollWithTimeout behaves like Get, but gives up after d instead of blocking
+// indefinitely.
+func (q *BlockingQueue[V]) PollWithTimeout(d time.Duration) (V, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+
+	return q.Get(ctx)
+}
+
+// wakePutter signals the oldest parked Put, if any, that it should retry.
+// Callers must hold q.mu.
+func (q *BlockingQueue[V]) wakePutter() {
+	front := q.putters.Front()
+	if front == nil {
+		return
+	}
+	w := front.Value.(*putWaiter)
+	q.putters.Remove(front)
+	w.ch <- struct{}{}
+}
+
+// removePutter drops elem from the putter list if it is still present,
+// i.e. nobody woke it between the select firing and us acquiring the lock.
+func (q *BlockingQueue[V]) removePutter(elem *list.Element) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for e := q.putters.Front(); e != nil; e = e.Next() {
+		if e == elem {
+			q.putters.Remove(e)
+			return
+		}
+	}
+}
+
+// removeGetter drops elem from the getter list if it is still present. If
+// a Put raced in and already delivered a value before we could remove
+// ourselves, that value is returned with ok set to true so it isn't lost.
+func (q *BlockingQueue[V]) removeGetter(elem *list.Element, w *getWaiter[V]) (V, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for e := q.getters.Front(); e != nil; e = e.Next() {
+		if e == elem {
+			q.getters.Remove(e)
+			return *new(V), false
+		}
+	}
+
+	select {
+	case v := <-w.ch:
+		return v, true
+	default:
+		return *new(V), false
+	}
+}
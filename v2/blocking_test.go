@@ -0,0 +1,219 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueuePutGet(t *testing.T) {
+	q := NewBlocking[int](0)
+
+	if err := q.Put(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Put(context.Background(), 2); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []int{1, 2} {
+		v, err := q.Get(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != want {
+			t.Error("get had value", v, "wanted", want)
+		}
+	}
+}
+
+func TestBlockingQueueGetBlocksUntilPut(t *testing.T) {
+	q := NewBlocking[int](0)
+
+	result := make(chan int, 1)
+	go func() {
+		v, err := q.Get(context.Background())
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		result <- v
+	}()
+
+	// Give the goroutine a chance to park before we put.
+	time.Sleep(10 * time.Millisecond)
+
+	if err := q.Put(context.Background(), 42); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case v := <-result:
+		if v != 42 {
+			t.Error("get had value", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Get never unblocked")
+	}
+}
+
+func TestBlockingQueueGetContextCancel(t *testing.T) {
+	q := NewBlocking[int](0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := q.Get(ctx); err != ctx.Err() {
+		t.Error("expected context error, got", err)
+	}
+}
+
+func TestBlockingQueuePollWithTimeout(t *testing.T) {
+	q := NewBlocking[int](0)
+
+	if _, err := q.PollWithTimeout(10 * time.Millisecond); err != context.DeadlineExceeded {
+		t.Error("expected deadline exceeded, got", err)
+	}
+
+	q.Put(context.Background(), 7)
+	v, err := q.PollWithTimeout(time.Second)
+	if err != nil || v != 7 {
+		t.Error("poll returned", v, err)
+	}
+}
+
+func TestBlockingQueueCapacityBlocksPut(t *testing.T) {
+	q := NewBlocking[int](1)
+
+	if err := q.Put(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- q.Put(context.Background(), 2)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Put should have blocked on a full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if _, err := q.Get(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Put never unblocked after room was freed")
+	}
+}
+
+func TestBlockingQueueDispose(t *testing.T) {
+	q := NewBlocking[int](0)
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := q.Get(context.Background())
+		result <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	q.Dispose()
+
+	select {
+	case err := <-result:
+		if err != ErrDisposed {
+			t.Error("expected ErrDisposed, got", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Get never unblocked after Dispose")
+	}
+
+	if !q.Disposed() {
+		t.Error("queue should report disposed")
+	}
+	if err := q.Put(context.Background(), 1); err != ErrDisposed {
+		t.Error("Put after Dispose should return ErrDisposed, got", err)
+	}
+	if _, err := q.Get(context.Background()); err != ErrDisposed {
+		t.Error("Get after Dispose should return ErrDisposed, got", err)
+	}
+}
+
+func TestBlockingQueueLengthAndPeek(t *testing.T) {
+	q := NewBlocking[int](0)
+
+	if _, err := q.Peek(); err != ErrQueueEmpty {
+		t.Error("expected ErrQueueEmpty on empty queue, got", err)
+	}
+	if n := q.Length(); n != 0 {
+		t.Error("expected length 0, got", n)
+	}
+
+	q.Put(context.Background(), 1)
+	q.Put(context.Background(), 2)
+
+	if n := q.Length(); n != 2 {
+		t.Error("expected length 2, got", n)
+	}
+	if v, err := q.Peek(); err != nil || v != 1 {
+		t.Error("peek returned", v, err)
+	}
+	if n := q.Length(); n != 2 {
+		t.Error("Peek should not remove the element, length was", n)
+	}
+}
+
+// TestBlockingQueuePutHandoffSurvivesGetCancel guards against a race where a
+// direct handoff to a parked Get could be lost: if Put removed the getter
+// from the list, unlocked, and only then sent on its channel, the getter's
+// context could be cancelled in that window and the value would be sent
+// into a channel nobody ever reads again.
+func TestBlockingQueuePutHandoffSurvivesGetCancel(t *testing.T) {
+	q := NewBlocking[int](0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	type getResult struct {
+		v   int
+		err error
+	}
+	getDone := make(chan getResult, 1)
+	go func() {
+		v, err := q.Get(ctx)
+		getDone <- getResult{v, err}
+	}()
+
+	// Give the Get time to park as a waiter, then race its cancellation
+	// against a Put that will try to hand off directly to it.
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := q.Put(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	res := <-getDone
+	if res.err == nil {
+		// The handoff won the race: the value was delivered to the
+		// cancelled Get, not lost.
+		if res.v != 1 {
+			t.Error("delivered value was", res.v, "want 1")
+		}
+		return
+	}
+
+	// Cancellation won the race: Put must have fallen back to buffering
+	// the value instead of stranding it in the getter's channel.
+	got, err := q.PollWithTimeout(time.Second)
+	if err != nil {
+		t.Fatal("value was lost: queue has nothing buffered:", err)
+	}
+	if got != 1 {
+		t.Error("buffered value was", got, "want 1")
+	}
+}
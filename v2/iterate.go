@@ -0,0 +1,80 @@
+package queue
+
+import "iter"
+
+// Range walks the queue from front to back, calling fn with the logical
+// index and value of each element. It stops early if fn returns false.
+// Range does not allocate: it walks the queue's two contiguous segments
+// (head..end, then 0..tail) directly.
+//
+// Range holds the queue's lock for the duration of the walk, matching
+// Snapshot; fn must not call back into q.
+func (q *Queue[V]) Range(fn func(i int, v V) bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count <= 0 {
+		return
+	}
+
+	end := q.head + q.count
+	if end <= len(q.buf) {
+		for i := q.head; i < end; i++ {
+			if !fn(i-q.head, *q.buf[i]) {
+				return
+			}
+		}
+		return
+	}
+
+	for i := q.head; i < len(q.buf); i++ {
+		if !fn(i-q.head, *q.buf[i]) {
+			return
+		}
+	}
+	for i := 0; i < q.tail; i++ {
+		if !fn(len(q.buf)-q.head+i, *q.buf[i]) {
+			return
+		}
+	}
+}
+
+// Snapshot returns a freshly allocated slice holding every element
+// currently in the queue, in FIFO order. Unlike Range, it does not hold
+// any reference to the queue's internal buffer, so it is safe to keep and
+// inspect after further pushes or pops.
+func (q *Queue[V]) Snapshot() []V {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	ret := make([]V, q.count)
+	if q.count <= 0 {
+		return ret
+	}
+
+	end := q.head + q.count
+	if end <= len(q.buf) {
+		for i := q.head; i < end; i++ {
+			ret[i-q.head] = *q.buf[i]
+		}
+		return ret
+	}
+
+	n := len(q.buf) - q.head
+	for i := 0; i < n; i++ {
+		ret[i] = *q.buf[q.head+i]
+	}
+	for i := 0; i < q.tail; i++ {
+		ret[n+i] = *q.buf[i]
+	}
+	return ret
+}
+
+// All returns an iterator over the queue's elements from front to back,
+// yielding each element's logical index alongside its value. The iterator
+// stops cleanly if the consuming range loop breaks early.
+func (q *Queue[V]) All() iter.Seq2[int, V] {
+	return func(yield func(int, V) bool) {
+		q.Range(yield)
+	}
+}
@@ -0,0 +1,113 @@
+package queue
+
+import "testing"
+
+func TestQueueRange(t *testing.T) {
+	q := New[int]()
+	for i := 0; i < minQueueLen; i++ {
+		q.Add(i)
+	}
+	// Make the buffer wrap so Range has to cross the boundary.
+	for i := 0; i < 3; i++ {
+		_, _ = q.Remove()
+		q.Add(minQueueLen + i)
+	}
+
+	var got []int
+	q.Range(func(i int, v int) bool {
+		if i != len(got) {
+			t.Errorf("range index %d out of order, got %d", len(got), i)
+		}
+		got = append(got, v)
+		return true
+	})
+
+	if len(got) != q.Length() {
+		t.Fatalf("range visited %d elements, want %d", len(got), q.Length())
+	}
+	for i, v := range got {
+		want, _ := q.Get(i)
+		if v != want {
+			t.Errorf("range[%d] = %d, want %d", i, v, want)
+		}
+	}
+}
+
+func TestQueueRangeStopsEarly(t *testing.T) {
+	q := New[int]()
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+
+	var seen []int
+	q.Range(func(i int, v int) bool {
+		seen = append(seen, v)
+		return v < 2
+	})
+
+	if len(seen) != 3 {
+		t.Errorf("range should have stopped after 3 elements, saw %d", len(seen))
+	}
+}
+
+func TestQueueSnapshot(t *testing.T) {
+	q := New[int]()
+
+	if s := q.Snapshot(); len(s) != 0 {
+		t.Errorf("snapshot of empty queue should be empty, got %v", s)
+	}
+
+	for i := 0; i < minQueueLen+5; i++ {
+		q.Add(i)
+	}
+	for i := 0; i < 3; i++ {
+		_, _ = q.Remove()
+		q.Add(minQueueLen + 5 + i)
+	}
+
+	snap := q.Snapshot()
+	if len(snap) != q.Length() {
+		t.Fatalf("snapshot has %d elements, want %d", len(snap), q.Length())
+	}
+	for i, v := range snap {
+		want, _ := q.Get(i)
+		if v != want {
+			t.Errorf("snapshot[%d] = %d, want %d", i, v, want)
+		}
+	}
+
+	// Mutating the queue afterwards should not affect the snapshot.
+	q.Add(999)
+	if len(snap) == q.Length() {
+		t.Error("snapshot should not track later mutations")
+	}
+}
+
+func TestQueueAll(t *testing.T) {
+	q := New[int]()
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+
+	i := 0
+	for idx, v := range q.All() {
+		if idx != i || v != i {
+			t.Errorf("all() yielded (%d, %d), want (%d, %d)", idx, v, i, i)
+		}
+		i++
+	}
+	if i != 10 {
+		t.Errorf("all() yielded %d pairs, want 10", i)
+	}
+
+	count := 0
+	for range q.All() {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("all() should stop cleanly when the loop breaks, got %d", count)
+	}
+}
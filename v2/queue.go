@@ -43,7 +43,13 @@ func (q *Queue[V]) Length() int {
 // resizes the queue to fit exactly twice its current contents
 // this can result in shrinking if the queue is less than half-full
 func (q *Queue[V]) resize() {
-	newBuf := make([]*V, q.count<<1)
+	q.resizeTo(q.count << 1)
+}
+
+// resizeTo rebuilds the queue around a buffer of the given capacity,
+// preserving order and unwrapping the contents so head sits at 0.
+func (q *Queue[V]) resizeTo(newCap int) {
+	newBuf := make([]*V, newCap)
 
 	if q.tail > q.head {
 		copy(newBuf, q.buf[q.head:q.tail])
@@ -57,6 +63,16 @@ func (q *Queue[V]) resize() {
 	q.buf = newBuf
 }
 
+// nextPowerOfTwo returns the smallest power of two that is >= n and >=
+// minQueueLen.
+func nextPowerOfTwo(n int) int {
+	newLen := minQueueLen
+	for newLen < n {
+		newLen <<= 1
+	}
+	return newLen
+}
+
 // Add puts an element on the end of the queue.
 func (q *Queue[V]) Add(elem V) {
 	q.mu.Lock()
@@ -117,3 +133,149 @@ func (q *Queue[V]) Remove() (V, error) {
 	}
 	return *ret, nil
 }
+
+// PushBack puts an element on the end of the queue. It is an alias for Add,
+// provided for symmetry with PushFront.
+func (q *Queue[V]) PushBack(elem V) {
+	q.Add(elem)
+}
+
+// PushFront puts an element on the front of the queue.
+func (q *Queue[V]) PushFront(elem V) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count == len(q.buf) {
+		q.resize()
+	}
+
+	// bitwise modulus
+	q.head = (q.head - 1) & (len(q.buf) - 1)
+	q.buf[q.head] = &elem
+	q.count++
+}
+
+// PopFront removes and returns the element from the front of the queue. It is
+// an alias for Remove, provided for symmetry with PopBack.
+func (q *Queue[V]) PopFront() (V, error) {
+	return q.Remove()
+}
+
+// PopBack removes and returns the element from the back of the queue. If the
+// queue is empty, the call will return error.
+func (q *Queue[V]) PopBack() (V, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count <= 0 {
+		return *new(V), ErrQueueEmpty
+	}
+	// bitwise modulus
+	q.tail = (q.tail - 1) & (len(q.buf) - 1)
+	ret := q.buf[q.tail]
+	q.buf[q.tail] = nil
+	q.count--
+	// Resize down if buffer 1/4 full.
+	if len(q.buf) > minQueueLen && (q.count<<2) == len(q.buf) {
+		q.resize()
+	}
+	return *ret, nil
+}
+
+// Front returns the element at the front of the queue. It is an alias for
+// Peek, provided for symmetry with Back.
+func (q *Queue[V]) Front() (V, error) {
+	return q.Peek()
+}
+
+// Back returns the element at the back of the queue. This call returns error
+// if the queue is empty.
+func (q *Queue[V]) Back() (V, error) {
+	if q.count <= 0 {
+		return *new(V), ErrQueueEmpty
+	}
+	// bitwise modulus
+	return *(q.buf[(q.tail-1)&(len(q.buf)-1)]), nil
+}
+
+// AddAll puts every element of elems on the end of the queue, growing the
+// underlying buffer at most once regardless of how many elements are
+// added.
+func (q *Queue[V]) AddAll(elems []V) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(elems) == 0 {
+		return
+	}
+
+	need := q.count + len(elems)
+	if need > len(q.buf) {
+		q.resizeTo(nextPowerOfTwo(need))
+	}
+
+	tail := q.tail
+	for i := range elems {
+		elem := elems[i]
+		q.buf[tail] = &elem
+		// bitwise modulus
+		tail = (tail + 1) & (len(q.buf) - 1)
+	}
+	q.tail = tail
+	q.count += len(elems)
+}
+
+// RemoveN removes and returns up to n elements from the front of the queue,
+// in FIFO order. If the queue is empty, the call will return error;
+// otherwise it returns as many elements as are available, which may be
+// fewer than n.
+func (q *Queue[V]) RemoveN(n int) ([]V, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count <= 0 {
+		return nil, ErrQueueEmpty
+	}
+	if n > q.count {
+		n = q.count
+	}
+
+	ret := make([]V, n)
+	head := q.head
+	for i := 0; i < n; i++ {
+		ret[i] = *q.buf[head]
+		q.buf[head] = nil
+		// bitwise modulus
+		head = (head + 1) & (len(q.buf) - 1)
+	}
+	q.head = head
+	q.count -= n
+
+	// Resize down if buffer is a quarter full, matching Remove's threshold.
+	if len(q.buf) > minQueueLen && (q.count<<2) == len(q.buf) {
+		q.resize()
+	}
+	return ret, nil
+}
+
+// Drain removes and returns every element currently in the queue, in FIFO
+// order, resetting the buffer back down to its minimum size.
+func (q *Queue[V]) Drain() []V {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.count <= 0 {
+		return nil
+	}
+
+	ret := make([]V, q.count)
+	head := q.head
+	for i := range ret {
+		ret[i] = *q.buf[head]
+		head = (head + 1) & (len(q.buf) - 1)
+	}
+
+	q.head, q.tail, q.count = 0, 0, 0
+	q.buf = make([]*V, minQueueLen)
+	return ret
+}
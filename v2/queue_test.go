@@ -125,6 +125,156 @@ func TestQueueRemoveOutOfRangePanics(t *testing.T) {
 	assertError(t, "should return empty queue error when removing emptied queue", err, ErrQueueEmpty)
 }
 
+func TestQueuePushFront(t *testing.T) {
+	q := New[int]()
+
+	for i := 0; i < minQueueLen; i++ {
+		q.PushFront(i)
+	}
+	for i := minQueueLen - 1; i >= 0; i-- {
+		r, _ := q.Front()
+		if r != i {
+			t.Error("front", i, "had value", r)
+		}
+		x, _ := q.PopFront()
+		if x != i {
+			t.Error("popfront", i, "had value", x)
+		}
+	}
+}
+
+func TestQueuePopBack(t *testing.T) {
+	q := New[int]()
+
+	for i := 0; i < minQueueLen; i++ {
+		q.PushBack(i)
+	}
+	for i := minQueueLen - 1; i >= 0; i-- {
+		r, _ := q.Back()
+		if r != i {
+			t.Error("back", i, "had value", r)
+		}
+		x, _ := q.PopBack()
+		if x != i {
+			t.Error("popback", i, "had value", x)
+		}
+	}
+}
+
+func TestQueueMixedPushPop(t *testing.T) {
+	q := New[int]()
+
+	// [0]
+	q.PushBack(0)
+	// [-1 0]
+	q.PushFront(-1)
+	// [-1 0 1]
+	q.PushBack(1)
+	// [-2 -1 0 1]
+	q.PushFront(-2)
+
+	for i, want := range []int{-2, -1, 0, 1} {
+		if r, _ := q.Get(i); r != want {
+			t.Errorf("index %d doesn't contain %d, has %d", i, want, r)
+		}
+	}
+
+	if r, _ := q.PopFront(); r != -2 {
+		t.Error("popfront had value", r)
+	}
+	if r, _ := q.PopBack(); r != 1 {
+		t.Error("popback had value", r)
+	}
+	if r, _ := q.PopFront(); r != -1 {
+		t.Error("popfront had value", r)
+	}
+	if r, _ := q.PopBack(); r != 0 {
+		t.Error("popback had value", r)
+	}
+	if _, err := q.PopFront(); err != ErrQueueEmpty {
+		t.Error("popfront on empty queue should error")
+	}
+}
+
+func TestQueueBackFrontOutOfRangeErrors(t *testing.T) {
+	q := New[int]()
+
+	_, err := q.Front()
+	assertError(t, "should return empty queue error when front of empty queue", err, ErrQueueEmpty)
+
+	_, err = q.Back()
+	assertError(t, "should return empty queue error when back of empty queue", err, ErrQueueEmpty)
+}
+
+func TestQueueAddAll(t *testing.T) {
+	q := New[int]()
+
+	q.Add(-1)
+	q.AddAll([]int{0, 1, 2, 3})
+
+	for i := -1; i < 4; i++ {
+		if r, _ := q.Peek(); r != i {
+			t.Error("peek", i, "had value", r)
+		}
+		_, _ = q.Remove()
+	}
+}
+
+func TestQueueRemoveN(t *testing.T) {
+	q := New[int]()
+
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+
+	elems, err := q.RemoveN(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range elems {
+		if e != i {
+			t.Error("removen", i, "had value", e)
+		}
+	}
+	if q.Length() != 6 {
+		t.Error("queue should have 6 elements left, has", q.Length())
+	}
+
+	elems, err = q.RemoveN(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(elems) != 6 {
+		t.Error("removen should clamp to remaining length, got", len(elems))
+	}
+
+	if _, err := q.RemoveN(1); err != ErrQueueEmpty {
+		t.Error("should error when removing from empty queue")
+	}
+}
+
+func TestQueueDrain(t *testing.T) {
+	q := New[int]()
+
+	if q.Drain() != nil {
+		t.Error("draining an empty queue should return nil")
+	}
+
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+
+	elems := q.Drain()
+	for i, e := range elems {
+		if e != i {
+			t.Error("drain", i, "had value", e)
+		}
+	}
+	if q.Length() != 0 {
+		t.Error("queue should be empty after drain, has", q.Length())
+	}
+}
+
 func assertError(t *testing.T, name string, actualErr error, expectedErr error) {
 	if actualErr != expectedErr {
 		t.Errorf("%s: didn't get error as expected", name)